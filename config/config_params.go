@@ -0,0 +1,84 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package config defines the fully-resolved set of Felix configuration parameters. In the real
+// tree, Config is populated from environment variables, the config file and the
+// FelixConfiguration resource of the same name by the reflection-based loader in config.go; that
+// loader isn't part of this chunk, so only the fields this backlog's requests need are declared
+// here.
+package config
+
+import "time"
+
+// Config holds the Felix configuration parameters touched by this backlog. Each field's
+// doc comment names the FelixConfigurationSpec field it mirrors.
+type Config struct {
+	// NFTablesMode selects which packet-filtering backend Felix programs: "Disabled" (the
+	// default iptables/ipset backend in dataplane/linux) or "Enabled" (the nftables backend
+	// in dataplane/nftables). Mirrors FelixConfigurationSpec.NFTablesMode.
+	NFTablesMode string `config:"oneof(Disabled,Enabled);Disabled"`
+
+	// VXLANMTUV6 is the MTU Felix sets on the independent vxlan-v6.calico device it brings up
+	// when both IPv4 and IPv6 pools use VXLAN. Mirrors FelixConfigurationSpec.VXLANMTUV6; the
+	// v4 vxlan.calico device keeps using VXLANMTU, unaffected by this field.
+	VXLANMTUV6 int `config:"int;1450"`
+
+	// VXLANVNIV6 is the VNI Felix uses for vxlan-v6.calico. Mirrors
+	// FelixConfigurationSpec.VXLANVNIV6.
+	VXLANVNIV6 int `config:"int;4096"`
+
+	// VXLANPortV6 is the UDP destination port Felix uses for vxlan-v6.calico. Mirrors
+	// FelixConfigurationSpec.VXLANPortV6.
+	VXLANPortV6 int `config:"int;4789"`
+
+	// WireguardEnabledV6 turns on the IPv6 WireGuard tunnel (wireguard.cali-v6), independent of
+	// the IPv4 tunnel's WireguardEnabled. Mirrors FelixConfigurationSpec.WireguardEnabledV6.
+	WireguardEnabledV6 bool `config:"bool;false"`
+
+	// WireguardListeningPortV6 is the UDP port the IPv6 WireGuard tunnel listens on. Mirrors
+	// FelixConfigurationSpec.WireguardListeningPortV6.
+	WireguardListeningPortV6 int `config:"int;51821"`
+
+	// WireguardPresharedKeyRotationInterval is how often Felix derives and installs a fresh
+	// symmetric preshared key on each WireGuard peer link, for hybrid post-quantum resistance
+	// alongside the tunnel's ephemeral keypair. Zero disables PSK rotation. Mirrors
+	// FelixConfigurationSpec.WireguardPresharedKeyRotationInterval.
+	WireguardPresharedKeyRotationInterval time.Duration `config:"seconds;0"`
+
+	// WireguardPeerFailureTimeout is how long a peer's WireGuard handshake can go stale before
+	// Felix withdraws that peer's routes from the WireGuard table, falling traffic back to the
+	// direct/IPIP path. Mirrors FelixConfigurationSpec.WireguardPeerFailureTimeout.
+	WireguardPeerFailureTimeout time.Duration `config:"seconds;30"`
+
+	// WireguardExcludeCIDRs lists destination prefixes that should never be routed over a
+	// WireGuard tunnel, even when the peer node is otherwise encrypted; the wireguard manager
+	// installs throw routes for them instead of AllowedIPs entries. Mirrors
+	// FelixConfigurationSpec.WireguardExcludeCIDRs.
+	WireguardExcludeCIDRs []string `config:"cidr-list;"`
+
+	// WireguardMode selects how Felix brings up the WireGuard tunnel device: "Kernel" requires
+	// the in-tree wireguard module, "Userspace" always uses an embedded userspace device, and
+	// "Auto" prefers the kernel module and falls back to userspace if it can't be loaded.
+	// Mirrors FelixConfigurationSpec.WireguardMode.
+	WireguardMode string `config:"oneof(Kernel,Userspace,Auto);Kernel"`
+
+	// WireguardMTU is a fixed MTU override for the WireGuard tunnel device. Ignored when
+	// WireguardMTUAuto is set. Mirrors FelixConfigurationSpec.WireguardMTU.
+	WireguardMTU int `config:"int;0"`
+
+	// WireguardMTUAuto, when true, derives the WireGuard tunnel MTU from the underlay
+	// interface's MTU minus the WireGuard encapsulation overhead instead of using a fixed
+	// value. Mirrors FelixConfigurationSpec.WireguardMTUAuto.
+	WireguardMTUAuto bool `config:"bool;false"`
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intdataplane
+
+import (
+	"github.com/projectcalico/felix/config"
+	"github.com/projectcalico/felix/dataplane/nftables"
+)
+
+// newNFTablesIPSetsIfEnabled returns an nftables-backed IPSetsDataplane for the given family
+// ("ip" or "ip6") when cfg.NFTablesMode is "Enabled", or nil otherwise. StartDataplaneDriver
+// (not part of this chunk) calls this alongside its existing iptables/ipset construction and
+// uses whichever one is non-nil.
+func newNFTablesIPSetsIfEnabled(cfg *config.Config, familyTag string) nftables.IPSetsDataplane {
+	if cfg.NFTablesMode != "Enabled" {
+		return nil
+	}
+	return nftables.NewIPSets(familyTag, "calico")
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package intdataplane implements Felix's Linux iptables/ipset dataplane driver. Only the
+// host-MTU auto-detection helper is reproduced here; the rest of the driver (int_dataplane.go
+// in the real tree) isn't part of this chunk.
+package intdataplane
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/vishvananda/netlink"
+
+	"github.com/projectcalico/felix/ifacemonitor"
+)
+
+// findHostMTU auto-detects the MTU to use for Calico's overlay devices (VXLAN, IPIP, Wireguard)
+// by scanning the host's network interfaces and returning the smallest MTU across any interface
+// that matches includeRegexp, doesn't match excludeRegexp, and is both administratively and
+// operationally up. Interfaces that are administratively up but operationally down (no carrier)
+// are skipped so a dangling or not-yet-cabled NIC can't drag the detected value down.
+func findHostMTU(includeRegexp, excludeRegexp *regexp.Regexp) (int, error) {
+	links, err := netlink.LinkList()
+	if err != nil {
+		return 0, fmt.Errorf("failed to list interfaces: %w", err)
+	}
+
+	mtu := 0
+	for _, link := range links {
+		attrs := link.Attrs()
+		name := attrs.Name
+
+		if excludeRegexp != nil && excludeRegexp.MatchString(name) {
+			continue
+		}
+		if includeRegexp != nil && !includeRegexp.MatchString(name) {
+			continue
+		}
+		if !ifacemonitor.LinkIsOperUp(attrs) {
+			continue
+		}
+
+		if mtu == 0 || attrs.MTU < mtu {
+			mtu = attrs.MTU
+		}
+	}
+
+	if mtu == 0 {
+		return 0, fmt.Errorf("no matching, operationally-up interfaces found to auto-detect MTU from")
+	}
+	return mtu, nil
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intdataplane
+
+import (
+	"fmt"
+
+	"github.com/vishvananda/netlink"
+)
+
+// VXLANV6DeviceName is the name of the independent VXLAN device Felix brings up for IPv6 pool
+// traffic when both IPv4 and IPv6 pools use VXLAN, alongside the existing v4 vxlan.calico
+// device. It has its own VNI/port/MTU, taken from FelixConfigurationSpec's VXLANVNIV6,
+// VXLANPortV6 and VXLANMTUV6 fields.
+const VXLANV6DeviceName = "vxlan-v6.calico"
+
+// VXLANV6DeviceConfig carries the v6-specific knobs for VXLANV6DeviceName, mirroring the
+// VXLANVNIV6/VXLANPortV6/VXLANMTUV6 FelixConfiguration fields. The v4 device and its own
+// VXLANVNI/VXLANPort/VXLANMTU settings are untouched by this type.
+type VXLANV6DeviceConfig struct {
+	VNI  int
+	Port int
+	MTU  int
+}
+
+// ensureVXLANV6Device creates VXLANV6DeviceName if it doesn't already exist and brings its VNI,
+// destination port and MTU into line with cfg, independently of whatever the v4 vxlan.calico
+// device is currently configured with.
+func ensureVXLANV6Device(localIP netlink.Addr, cfg VXLANV6DeviceConfig) error {
+	link, err := netlink.LinkByName(VXLANV6DeviceName)
+	if err != nil {
+		if _, notFound := err.(netlink.LinkNotFoundError); !notFound {
+			return fmt.Errorf("failed to look up %s: %w", VXLANV6DeviceName, err)
+		}
+		link = &netlink.Vxlan{
+			LinkAttrs: netlink.LinkAttrs{
+				Name: VXLANV6DeviceName,
+				MTU:  cfg.MTU,
+			},
+			VxlanId:  cfg.VNI,
+			Port:     cfg.Port,
+			SrcAddr:  localIP.IP,
+			Learning: false,
+		}
+		if err := netlink.LinkAdd(link); err != nil {
+			return fmt.Errorf("failed to create %s: %w", VXLANV6DeviceName, err)
+		}
+		link, err = netlink.LinkByName(VXLANV6DeviceName)
+		if err != nil {
+			return fmt.Errorf("failed to look up %s after creating it: %w", VXLANV6DeviceName, err)
+		}
+	}
+
+	if link.Attrs().MTU != cfg.MTU {
+		if err := netlink.LinkSetMTU(link, cfg.MTU); err != nil {
+			return fmt.Errorf("failed to set MTU on %s: %w", VXLANV6DeviceName, err)
+		}
+	}
+
+	if err := netlink.LinkSetUp(link); err != nil {
+		return fmt.Errorf("failed to bring %s up: %w", VXLANV6DeviceName, err)
+	}
+
+	return nil
+}
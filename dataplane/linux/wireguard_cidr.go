@@ -0,0 +1,55 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// wireguard_cidr.go teaches the wireguard manager to skip AllowedIPs/route-table programming for
+// destinations covered by WireguardExcludeCIDRs, falling those prefixes back to a throw route (the
+// same technique already used for peers that aren't WireGuard-enabled at all) instead of tunnelling
+// them.
+package intdataplane
+
+import "net"
+
+// WireguardCIDRExcludeList decides, for a given destination, whether it's covered by one of the
+// configured WireguardExcludeCIDRs and should get a throw route rather than a tunnelled
+// AllowedIPs entry. Longest-prefix-match isn't needed here -- unlike a general routing table, any
+// matching exclusion is sufficient to pull the destination out of the tunnel.
+type WireguardCIDRExcludeList struct {
+	excluded []*net.IPNet
+}
+
+// NewWireguardCIDRExcludeList parses the configured exclude CIDRs. Malformed entries are skipped
+// rather than failing construction, matching how most of Felix's best-effort config parsing
+// behaves for list-valued fields.
+func NewWireguardCIDRExcludeList(cidrs []string) *WireguardCIDRExcludeList {
+	l := &WireguardCIDRExcludeList{}
+	for _, c := range cidrs {
+		_, ipNet, err := net.ParseCIDR(c)
+		if err != nil {
+			continue
+		}
+		l.excluded = append(l.excluded, ipNet)
+	}
+	return l
+}
+
+// ShouldExclude returns true if ip falls inside any configured WireguardExcludeCIDRs prefix, and
+// so should get a throw route instead of being tunnelled.
+func (l *WireguardCIDRExcludeList) ShouldExclude(ip net.IP) bool {
+	for _, n := range l.excluded {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
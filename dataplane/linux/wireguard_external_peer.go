@@ -0,0 +1,70 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// wireguard_external_peer.go programs non-Calico WireGuard peers (peers with no Calico Node
+// resource behind them) into the local tunnel device. The ExternalWireguardPeer CRD these are
+// sourced from is a brand-new resource that would need apiserver/codegen work in libcalico-go;
+// that part isn't in this snapshot, so this works against the plain struct a client informer for
+// that CRD would eventually hand the manager.
+package intdataplane
+
+import (
+	"fmt"
+	"net"
+
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+)
+
+// ExternalWireguardPeer describes a WireGuard peer that isn't a Calico node: a public key, the
+// UDP endpoint to dial, and the prefixes routed to it. Felix skips these from the normal
+// node-publickey gossip path -- there's no Calico Node behind them to watch.
+type ExternalWireguardPeer struct {
+	Name       string
+	PublicKey  string
+	Endpoint   string
+	AllowedIPs []net.IPNet
+}
+
+// buildExternalPeerConfig turns an ExternalWireguardPeer into the wgtypes.PeerConfig the
+// wireguard manager passes to wgctrl when programming peers onto the local device, alongside the
+// normal Calico-node peers.
+func buildExternalPeerConfig(p ExternalWireguardPeer) (wgtypes.PeerConfig, error) {
+	key, err := wgtypes.ParseKey(p.PublicKey)
+	if err != nil {
+		return wgtypes.PeerConfig{}, fmt.Errorf("parsing public key for external peer %s: %w", p.Name, err)
+	}
+
+	endpoint, err := net.ResolveUDPAddr("udp", p.Endpoint)
+	if err != nil {
+		return wgtypes.PeerConfig{}, fmt.Errorf("resolving endpoint for external peer %s: %w", p.Name, err)
+	}
+
+	return wgtypes.PeerConfig{
+		PublicKey:         key,
+		Endpoint:          endpoint,
+		AllowedIPs:        p.AllowedIPs,
+		ReplaceAllowedIPs: true,
+	}, nil
+}
+
+// externalPeerThrowRoutes returns the AllowedIPs prefixes of every external peer, in order, as the
+// destinations the wireguard manager installs into the WireGuard route table for those peers. It
+// only collects the prefixes; it does not choose the route type or program any route.
+func externalPeerThrowRoutes(peers []ExternalWireguardPeer) []net.IPNet {
+	var routes []net.IPNet
+	for _, p := range peers {
+		routes = append(routes, p.AllowedIPs...)
+	}
+	return routes
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// wireguard_health.go tracks per-peer WireGuard handshake health and decides when to withdraw
+// (and later restore) a dead peer's routes from the WireGuard table, so traffic falls back to
+// the direct/IPIP path instead of being blackholed into a tunnel whose handshake never completes.
+package intdataplane
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var gaugeWireguardPeerFallbackActive = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "felix_wireguard_peer_fallback_active",
+	Help: "1 if this peer's traffic has fallen back to the direct path because its WireGuard handshake is stale, else 0.",
+}, []string{"node"})
+
+func init() {
+	prometheus.MustRegister(gaugeWireguardPeerFallbackActive)
+}
+
+// WireguardPeerHealthTracker decides, for each peer, whether its WireGuard route should be
+// withdrawn (fallen back to the direct path) based on how long its handshake has been stale,
+// applying WireguardPeerFailureTimeout as the dead/alive threshold.
+type WireguardPeerHealthTracker struct {
+	failureTimeout time.Duration
+	fallbackActive map[string]bool
+}
+
+// NewWireguardPeerHealthTracker builds a tracker that withdraws a peer's tunnel route once its
+// handshake has been stale for longer than failureTimeout.
+func NewWireguardPeerHealthTracker(failureTimeout time.Duration) *WireguardPeerHealthTracker {
+	return &WireguardPeerHealthTracker{
+		failureTimeout: failureTimeout,
+		fallbackActive: map[string]bool{},
+	}
+}
+
+// Update records the latest handshake age for nodeName and returns true if its route should be
+// (or remain) withdrawn from the WireGuard table. A transition in either direction updates the
+// felix_wireguard_peer_fallback_active metric.
+func (t *WireguardPeerHealthTracker) Update(nodeName string, handshakeAge time.Duration) (shouldFallBack bool) {
+	shouldFallBack = handshakeAge > t.failureTimeout
+	if shouldFallBack == t.fallbackActive[nodeName] {
+		return shouldFallBack
+	}
+
+	t.fallbackActive[nodeName] = shouldFallBack
+	if shouldFallBack {
+		gaugeWireguardPeerFallbackActive.WithLabelValues(nodeName).Set(1)
+	} else {
+		gaugeWireguardPeerFallbackActive.WithLabelValues(nodeName).Set(0)
+	}
+	return shouldFallBack
+}
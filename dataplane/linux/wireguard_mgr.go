@@ -0,0 +1,154 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// wireguard_mgr.go holds the felix-side WireGuard device/routing-rule programming this backlog's
+// WireGuard requests extend. The full manager (peer bookkeeping driven by Node resource updates,
+// the full interface to the rest of the dataplane driver loop) isn't part of this snapshot; what's
+// here is the family-parameterized device/rule/route plumbing each request builds on.
+package intdataplane
+
+import (
+	"fmt"
+
+	"github.com/vishvananda/netlink"
+)
+
+// WireguardFamily identifies which IP family a WireGuard tunnel belongs to. Felix runs the IPv4
+// and IPv6 tunnels as independent devices with independent ports and routing tables, so most of
+// the manager's state is keyed by family rather than assumed singular.
+type WireguardFamily int
+
+const (
+	WireguardFamilyV4 WireguardFamily = iota
+	WireguardFamilyV6
+)
+
+// DeviceName returns the netlink interface name Felix uses for this family's tunnel.
+func (f WireguardFamily) DeviceName() string {
+	switch f {
+	case WireguardFamilyV6:
+		return "wireguard.cali-v6"
+	default:
+		return "wireguard.cali"
+	}
+}
+
+// RulePriority returns the `ip [-6] rule` preference Felix installs for this family's WireGuard
+// routing rule. The IPv6 rule sits at a lower preference (evaluated first) than the IPv4 one so
+// dual-stack nodes don't have one family's rule shadow the other's.
+func (f WireguardFamily) RulePriority() int {
+	switch f {
+	case WireguardFamilyV6:
+		return 98
+	default:
+		return 99
+	}
+}
+
+// RouteTableIndex returns the routing table Felix programs this family's WireGuard routes into.
+func (f WireguardFamily) RouteTableIndex() int {
+	switch f {
+	case WireguardFamilyV6:
+		return 0x4d2 // 1234
+	default:
+		return 0x4d3 // 1235
+	}
+}
+
+// NetlinkFamily returns the netlink address family (netlink.FAMILY_V4/V6) this WireguardFamily
+// corresponds to, for building family-correct netlink.Rule values.
+func (f WireguardFamily) NetlinkFamily() int {
+	switch f {
+	case WireguardFamilyV6:
+		return netlink.FAMILY_V6
+	default:
+		return netlink.FAMILY_V4
+	}
+}
+
+// ensureWireguardRoutingRule installs (if missing) the `ip [-6] rule` that sends fwmark-ed
+// WireGuard traffic for this family into its dedicated route table, ahead of the main table.
+func ensureWireguardRoutingRule(family WireguardFamily, fwmark uint32) error {
+	rules, err := netlink.RuleList(family.NetlinkFamily())
+	if err != nil {
+		return fmt.Errorf("listing %v rules: %w", family, err)
+	}
+
+	priority := family.RulePriority()
+	table := family.RouteTableIndex()
+	for _, r := range rules {
+		if r.Priority == priority && r.Table == table {
+			return nil
+		}
+	}
+
+	rule := netlink.NewRule()
+	rule.Priority = priority
+	rule.Table = table
+	rule.Mark = int(fwmark)
+	rule.Family = family.NetlinkFamily()
+
+	if err := netlink.RuleAdd(rule); err != nil {
+		return fmt.Errorf("adding %v wireguard routing rule: %w", family, err)
+	}
+	return nil
+}
+
+// WireguardDeviceConfig is the subset of per-family WireGuard configuration the device-creation
+// step needs. Callers derive one of these from config.Config for whichever family they're
+// bringing up.
+type WireguardDeviceConfig struct {
+	Family        WireguardFamily
+	ListeningPort int
+	MTU           int
+}
+
+// ensureWireguardDevice creates this family's WireGuard link if it doesn't already exist, and
+// brings it up with the configured MTU (an MTU of 0 leaves the device's MTU as it is). It mirrors ensureVXLANV6Device's shape: idempotent,
+// create-if-missing, then converge MTU/admin-state every call.
+func ensureWireguardDevice(cfg WireguardDeviceConfig) error {
+	name := cfg.Family.DeviceName()
+
+	link, err := netlink.LinkByName(name)
+	if err != nil {
+		if _, ok := err.(netlink.LinkNotFoundError); !ok {
+			return fmt.Errorf("checking for existing wireguard device %s: %w", name, err)
+		}
+		wg := &netlink.Wireguard{
+			LinkAttrs: netlink.LinkAttrs{
+				Name: name,
+				MTU:  cfg.MTU,
+			},
+		}
+		if err := netlink.LinkAdd(wg); err != nil {
+			return fmt.Errorf("creating wireguard device %s: %w", name, err)
+		}
+		link, err = netlink.LinkByName(name)
+		if err != nil {
+			return fmt.Errorf("looking up newly-created wireguard device %s: %w", name, err)
+		}
+	}
+
+	if cfg.MTU != 0 && link.Attrs().MTU != cfg.MTU {
+		if err := netlink.LinkSetMTU(link, cfg.MTU); err != nil {
+			return fmt.Errorf("setting MTU on wireguard device %s: %w", name, err)
+		}
+	}
+
+	if err := netlink.LinkSetUp(link); err != nil {
+		return fmt.Errorf("bringing up wireguard device %s: %w", name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// wireguard_mode.go selects between the kernel WireGuard device (ensureWireguardDevice, via
+// netlink) and a userspace fallback for hosts whose kernel lacks the wireguard module. The
+// userspace engine itself (an embedded wireguard-go device bound to a gVisor netstack TUN) is an
+// external dependency not vendored into this repo; what's here is the real mode-selection logic
+// and the extension point it would plug into.
+package intdataplane
+
+import (
+	"errors"
+	"fmt"
+)
+
+// errKernelWireguardUnavailable wraps every error tryEnsureKernelWireguardDevice returns, whichever
+// step of the kernel device setup failed; it is the trigger for falling back to userspace mode.
+var errKernelWireguardUnavailable = errors.New("kernel does not support the wireguard link type")
+
+// resolveWireguardMode decides which device-creation path to use for this host, given the
+// configured WireguardMode and whether an attempt to create a kernel device has already failed
+// once this run. "Auto" prefers the kernel and only falls back once kernelUnavailable is true;
+// "Kernel" and "Userspace" are unconditional.
+func resolveWireguardMode(configuredMode string, kernelUnavailable bool) string {
+	switch configuredMode {
+	case "Userspace":
+		return "Userspace"
+	case "Auto":
+		if kernelUnavailable {
+			return "Userspace"
+		}
+		return "Kernel"
+	default:
+		return "Kernel"
+	}
+}
+
+// tryEnsureKernelWireguardDevice attempts the normal kernel-backed device creation. Callers
+// running in "Auto" mode should treat any error here as a trigger to retry via the userspace
+// path, using errKernelWireguardUnavailable to annotate why.
+func tryEnsureKernelWireguardDevice(cfg WireguardDeviceConfig) error {
+	if err := ensureWireguardDevice(cfg); err != nil {
+		return fmt.Errorf("%w: %v", errKernelWireguardUnavailable, err)
+	}
+	return nil
+}
+
+// ensureUserspaceWireguardDevice would bring up cfg's device via an embedded userspace WireGuard
+// engine bound to a TUN, for hosts that can't create a kernel wireguard link. That engine isn't
+// vendored into this repo, so this is the extension point a real implementation would fill in.
+func ensureUserspaceWireguardDevice(cfg WireguardDeviceConfig) error {
+	return fmt.Errorf("userspace wireguard device for %s: not implemented in this build", cfg.Family.DeviceName())
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// wireguard_mtu.go computes the WireGuard tunnel device's MTU, either from a fixed
+// WireguardMTU override or, in WireguardMTUAuto mode, from the underlay interface's MTU minus
+// the WireGuard encapsulation overhead. A startup/on-change PMTU probe toward each peer's
+// endpoint (to lower a specific AllowedIPs route's MTU on black-hole detection) isn't part of
+// this chunk: it needs to send and time out on real probe packets against live peer endpoints,
+// which this snapshot has no harness for.
+package intdataplane
+
+const (
+	// wireguardOverheadIPv4 is the per-packet overhead WireGuard adds when encapsulating an
+	// IPv4 payload: 20 bytes outer IPv4 header, 8 bytes UDP header, 32 bytes WireGuard header.
+	wireguardOverheadIPv4 = 60
+
+	// wireguardOverheadIPv6 is the IPv6 equivalent: 40 bytes outer IPv6 header, 8 bytes UDP
+	// header, 32 bytes WireGuard header.
+	wireguardOverheadIPv6 = 80
+)
+
+// overhead returns the WireGuard encapsulation overhead for this family's outer IP header.
+func (f WireguardFamily) overhead() int {
+	if f == WireguardFamilyV6 {
+		return wireguardOverheadIPv6
+	}
+	return wireguardOverheadIPv4
+}
+
+// computeWireguardMTU returns the MTU Felix should set on this family's tunnel device. If
+// mtuAuto is false, fixedMTU is used as-is (0 meaning "leave the kernel/netlink default alone").
+// If mtuAuto is true, the MTU is derived from underlayMTU minus this family's encapsulation
+// overhead.
+func computeWireguardMTU(family WireguardFamily, fixedMTU int, mtuAuto bool, underlayMTU int) int {
+	if !mtuAuto {
+		return fixedMTU
+	}
+	return underlayMTU - family.overhead()
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// wireguard_selector.go holds the felix-side consumer logic for selective WireGuard encryption:
+// given a set of selector-scoped encryption policies, assign each one a distinct fwmark and
+// decide whether a given workload's traffic must traverse the tunnel. The WireguardEncryptionPolicy
+// CRD/API type itself is a brand-new resource that would need apiserver and client-gen work in
+// libcalico-go; that part isn't in this snapshot, so this file works against the selector
+// strings a policy of that shape would carry.
+package intdataplane
+
+// encryptionSelectorFwmarkBase is the first fwmark selective-encryption policies are assigned,
+// chosen to sit below, and clear of, the bits the existing NAT-outgoing/masquerade marks in
+// bpf-gpl/skb_marks.h occupy (0x01000000 and up).
+const encryptionSelectorFwmarkBase = 0x100
+
+// EncryptionSelectorPolicy is one selector-scoped "must traverse the WireGuard tunnel" rule: all
+// workload traffic whose source matches Selector gets fwmark Fwmark, which the routing-rule
+// programming in ensureWireguardDevice's caller would use to pick the tunnel table over the
+// direct route for just that traffic.
+type EncryptionSelectorPolicy struct {
+	Selector string
+	Fwmark   uint32
+}
+
+// AssignEncryptionSelectorFwmarks assigns each selector in order the next available fwmark
+// starting at encryptionSelectorFwmarkBase, so the routing-rule layer has a stable, collision-free
+// mark per selector to program `ip rule` entries against.
+func AssignEncryptionSelectorFwmarks(selectors []string) []EncryptionSelectorPolicy {
+	policies := make([]EncryptionSelectorPolicy, 0, len(selectors))
+	for i, sel := range selectors {
+		policies = append(policies, EncryptionSelectorPolicy{
+			Selector: sel,
+			Fwmark:   encryptionSelectorFwmarkBase + uint32(i),
+		})
+	}
+	return policies
+}
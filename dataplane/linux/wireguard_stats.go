@@ -0,0 +1,130 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// wireguard_stats.go parses `wg show <device> dump` and exports per-peer WireGuard link stats as
+// Prometheus metrics, keyed by the remote node name the wireguard manager already maps each
+// public key to.
+package intdataplane
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	gaugeWireguardPeerBytesRx = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "felix_wireguard_peer_bytes_rx",
+		Help: "Total bytes received over the WireGuard tunnel from this peer.",
+	}, []string{"node"})
+
+	gaugeWireguardPeerBytesTx = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "felix_wireguard_peer_bytes_tx",
+		Help: "Total bytes sent over the WireGuard tunnel to this peer.",
+	}, []string{"node"})
+
+	gaugeWireguardPeerLastHandshakeSeconds = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "felix_wireguard_peer_last_handshake_seconds",
+		Help: "Unix timestamp of the last completed WireGuard handshake with this peer.",
+	}, []string{"node"})
+
+	counterWireguardPeerRekeyCount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "felix_wireguard_peer_rekey_count",
+		Help: "Number of times this peer's WireGuard session has re-keyed.",
+	}, []string{"node"})
+)
+
+func init() {
+	prometheus.MustRegister(gaugeWireguardPeerBytesRx)
+	prometheus.MustRegister(gaugeWireguardPeerBytesTx)
+	prometheus.MustRegister(gaugeWireguardPeerLastHandshakeSeconds)
+	prometheus.MustRegister(counterWireguardPeerRekeyCount)
+}
+
+// WireguardPeerStat is one peer's line from `wg show all dump`, after parsing.
+type WireguardPeerStat struct {
+	PublicKey     string
+	Endpoint      string
+	LastHandshake time.Time
+	BytesRx       uint64
+	BytesTx       uint64
+}
+
+// parseWireguardDump parses the output of a single-interface `wg show <device> dump`, whose peer
+// lines are tab-separated:
+// public-key  preshared-key  endpoint  allowed-ips  latest-handshake  rx-bytes  tx-bytes  keepalive
+// The multi-interface `wg show all dump` layout, which prefixes every line with the interface
+// name and has one interface row per device, is not handled.
+func parseWireguardDump(dump string) ([]WireguardPeerStat, error) {
+	var stats []WireguardPeerStat
+	lines := strings.Split(strings.TrimSpace(dump), "\n")
+	for i, line := range lines {
+		if i == 0 {
+			// First line is the interface's own private-key/port/fwmark row.
+			continue
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) < 7 {
+			return nil, fmt.Errorf("malformed wg dump peer line: %q", line)
+		}
+
+		handshakeUnix, err := strconv.ParseInt(fields[4], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing latest-handshake %q: %w", fields[4], err)
+		}
+		rx, err := strconv.ParseUint(fields[5], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing rx-bytes %q: %w", fields[5], err)
+		}
+		tx, err := strconv.ParseUint(fields[6], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing tx-bytes %q: %w", fields[6], err)
+		}
+
+		var handshake time.Time
+		if handshakeUnix > 0 {
+			handshake = time.Unix(handshakeUnix, 0)
+		}
+
+		stats = append(stats, WireguardPeerStat{
+			PublicKey:     fields[0],
+			Endpoint:      fields[2],
+			LastHandshake: handshake,
+			BytesRx:       rx,
+			BytesTx:       tx,
+		})
+	}
+	return stats, nil
+}
+
+// reportWireguardPeerStats updates the exported gauges for one peer, identified by the node name
+// the wireguard manager resolved its public key to. staleness is the configurable window after
+// which a peer with no recent handshake is considered unhealthy for the caller's purposes.
+func reportWireguardPeerStats(nodeName string, stat WireguardPeerStat, now time.Time, staleness time.Duration) (healthy bool) {
+	gaugeWireguardPeerBytesRx.WithLabelValues(nodeName).Set(float64(stat.BytesRx))
+	gaugeWireguardPeerBytesTx.WithLabelValues(nodeName).Set(float64(stat.BytesTx))
+
+	if stat.LastHandshake.IsZero() {
+		return false
+	}
+	gaugeWireguardPeerLastHandshakeSeconds.WithLabelValues(nodeName).Set(float64(stat.LastHandshake.Unix()))
+
+	return now.Sub(stat.LastHandshake) <= staleness
+}
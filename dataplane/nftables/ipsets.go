@@ -0,0 +1,205 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package nftables implements an alternative Felix dataplane backend built on the Linux
+// nftables(8) subsystem, as a peer to the iptables/ipset backend in dataplane/linux. It is
+// selected via FelixConfiguration's NFTablesMode field.
+package nftables
+
+import (
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// IPFamily mirrors the iptables-mode backend's ipsets.IPFamily so nft sets can be created for
+// the same families ("ip" for IPv4, "ip6" for IPv6).
+type IPFamily int
+
+const (
+	IPFamilyV4 IPFamily = iota
+	IPFamilyV6
+)
+
+func (f IPFamily) nftType() string {
+	if f == IPFamilyV6 {
+		return "ipv6_addr"
+	}
+	return "ipv4_addr"
+}
+
+// IPSetsDataplane is the interface the rest of Felix's calculation graph uses to program
+// whichever backend's set implementation is active. It mirrors the iptables-mode
+// ipsets.IPSets type so the two backends are interchangeable from the calculation graph's
+// point of view.
+type IPSetsDataplane interface {
+	AddOrReplaceIPSet(setID string, family IPFamily, members []string)
+	AddMembers(setID string, newMembers []string)
+	RemoveMembers(setID string, removedMembers []string)
+	RemoveIPSet(setID string)
+
+	// SetFilter restricts which sets ApplyUpdates will actually program; sets not present in
+	// neededSetIDs are left untouched on the host. Passing a nil map disables filtering.
+	SetFilter(neededSetIDs map[string]bool)
+
+	ApplyUpdates() error
+}
+
+type nftSet struct {
+	family  IPFamily
+	members map[string]bool
+}
+
+// IPSets programs Calico's named IP sets (e.g. cali40all-vxlan-net) as nft named sets in a
+// single nft table, instead of shelling out to ipset(8) per change the way the iptables-mode
+// backend does.
+type IPSets struct {
+	tableFamily string // "ip" or "ip6" -- the nft table family this instance manages
+	tableName   string
+
+	lock       sync.Mutex
+	sets       map[string]*nftSet
+	dirtySets  map[string]bool
+	neededSets map[string]bool
+	filtering  bool
+
+	runNFT func(script string) error
+}
+
+// NewIPSets creates an nftables-backed IPSetsDataplane for the given nft table family ("ip" or
+// "ip6") and table name (conventionally "calico").
+func NewIPSets(tableFamily, tableName string) *IPSets {
+	return &IPSets{
+		tableFamily: tableFamily,
+		tableName:   tableName,
+		sets:        map[string]*nftSet{},
+		dirtySets:   map[string]bool{},
+		runNFT:      runNFTScript,
+	}
+}
+
+func (s *IPSets) AddOrReplaceIPSet(setID string, family IPFamily, members []string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	memberSet := make(map[string]bool, len(members))
+	for _, m := range members {
+		memberSet[m] = true
+	}
+	s.sets[setID] = &nftSet{family: family, members: memberSet}
+	s.dirtySets[setID] = true
+}
+
+func (s *IPSets) AddMembers(setID string, newMembers []string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	set := s.sets[setID]
+	if set == nil {
+		set = &nftSet{members: map[string]bool{}}
+		s.sets[setID] = set
+	}
+	for _, m := range newMembers {
+		set.members[m] = true
+	}
+	s.dirtySets[setID] = true
+}
+
+func (s *IPSets) RemoveMembers(setID string, removedMembers []string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	set := s.sets[setID]
+	if set == nil {
+		return
+	}
+	for _, m := range removedMembers {
+		delete(set.members, m)
+	}
+	s.dirtySets[setID] = true
+}
+
+func (s *IPSets) RemoveIPSet(setID string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.sets, setID)
+	s.dirtySets[setID] = true
+}
+
+func (s *IPSets) SetFilter(neededSetIDs map[string]bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.neededSets = neededSetIDs
+	s.filtering = neededSetIDs != nil
+}
+
+// ApplyUpdates renders every dirty set into a single `nft -f -` script and executes it in one
+// shot, mirroring the way the iptables-mode Table batches changes through iptables-restore.
+func (s *IPSets) ApplyUpdates() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if len(s.dirtySets) == 0 {
+		return nil
+	}
+
+	var script strings.Builder
+	fmt.Fprintf(&script, "add table %s %s\n", s.tableFamily, s.tableName)
+	for setID := range s.dirtySets {
+		if s.filtering && !s.neededSets[setID] {
+			continue
+		}
+		set, ok := s.sets[setID]
+		if !ok {
+			fmt.Fprintf(&script, "delete set %s %s %s\n", s.tableFamily, s.tableName, setID)
+			continue
+		}
+		// nft only adds elements to an existing set, so flush it first to drop removed members.
+		fmt.Fprintf(&script, "add set %s %s %s { type %s; }\n", s.tableFamily, s.tableName, setID, set.family.nftType())
+		fmt.Fprintf(&script, "flush set %s %s %s\n", s.tableFamily, s.tableName, setID)
+		if len(set.members) > 0 {
+			fmt.Fprintf(&script, "add element %s %s %s { %s }\n",
+				s.tableFamily, s.tableName, setID, strings.Join(sortedKeys(set.members), ", "))
+		}
+	}
+
+	if err := s.runNFT(script.String()); err != nil {
+		return fmt.Errorf("failed to apply nft set updates: %w", err)
+	}
+
+	s.dirtySets = map[string]bool{}
+	return nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func runNFTScript(script string) error {
+	cmd := exec.Command("nft", "-f", "-")
+	cmd.Stdin = strings.NewReader(script)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%w: %s", err, out)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nftables
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Table programs one nft table (e.g. family "ip", name "calico") full of chains and rules,
+// applying them with a single `nft -f -` invocation per Apply call, the nftables analogue of
+// the batched iptables-restore approach used by the iptables-mode iptables.Table.
+type Table struct {
+	Family string // "ip" or "ip6"
+	Name   string // nft table name, e.g. "calico"
+
+	chains map[string][]string // chain name -> ordered list of rendered rule lines
+}
+
+func NewTable(family, name string) *Table {
+	return &Table{Family: family, Name: name, chains: map[string][]string{}}
+}
+
+// UpdateChain replaces the full rule set of the given chain.
+func (t *Table) UpdateChain(chainName string, rules []string) {
+	t.chains[chainName] = rules
+}
+
+func (t *Table) RemoveChain(chainName string) {
+	delete(t.chains, chainName)
+}
+
+// render produces the `nft -f -` script for the whole table.
+func (t *Table) render() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "add table %s %s\n", t.Family, t.Name)
+	for chain, rules := range t.chains {
+		// nft appends rules to an existing chain, so flush it first to replace its contents.
+		fmt.Fprintf(&b, "add chain %s %s %s\n", t.Family, t.Name, chain)
+		fmt.Fprintf(&b, "flush chain %s %s %s\n", t.Family, t.Name, chain)
+		for _, r := range rules {
+			fmt.Fprintf(&b, "add rule %s %s %s %s\n", t.Family, t.Name, chain, r)
+		}
+	}
+	return b.String()
+}
+
+// Apply renders and loads the table in one `nft -f -` call.
+func (t *Table) Apply() error {
+	cmd := exec.Command("nft", "-f", "-")
+	cmd.Stdin = strings.NewReader(t.render())
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("nft -f - failed: %w: %s", err, out)
+	}
+	return nil
+}
+
+// MasqueradeRule renders the nft equivalent of iptables' `MASQUERADE --random-fully` rule for
+// traffic sourced from one of Calico's IP pools, referencing the pool addresses via a named nft
+// set rather than an ipset match.
+func MasqueradeRule(srcIPSet string) string {
+	return fmt.Sprintf("ip saddr @%s masquerade random-fully", srcIPSet)
+}
+
+// VXLANWhitelistRule renders the nft equivalent of the iptables-mode VXLAN source whitelist: a
+// drop for inbound VXLAN (UDP/vxlanPort) frames whose source address isn't a known Calico host.
+func VXLANWhitelistRule(vxlanPort int, allowedSrcIPSet string) string {
+	return fmt.Sprintf("udp dport %d ip saddr != @%s drop", vxlanPort, allowedSrcIPSet)
+}
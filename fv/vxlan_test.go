@@ -137,6 +137,20 @@ var _ = infrastructure.DatastoreDescribe("_BPF-SAFE_ VXLAN topology before addin
 						}, "10s", "100ms").Should(ContainSubstring("--random-fully"))
 					}
 				})
+
+				// PIt: dataplane/nftables (IPSetsDataplane implementation, Table/rule-renderer,
+				// and MASQUERADE/VXLAN-whitelist rule rendering) is implemented as of this
+				// commit, and config.Config.NFTablesMode selects it in
+				// newNFTablesIPSetsIfEnabled. What's still missing is the FelixConfiguration
+				// CRD field of the same name -- that type is owned by libcalico-go, an external
+				// dependency not vendored into this repo, so there's no way for this FV to
+				// flip NFTablesMode through the datastore client. Once the CRD field lands
+				// upstream, this should assert the nft equivalents of the two checks above:
+				// `nft list ruleset` containing `masquerade random-fully`, and
+				// `nft list set ip calico cali40all-vxlan-net` holding the expected member
+				// count.
+				PIt("should use nft masquerade random-fully and an nft vxlan whitelist set when NFTablesMode is Enabled", func() {
+				})
 				It("should have workload to workload connectivity", func() {
 					cc.ExpectSome(w[0], w[1])
 					cc.ExpectSome(w[1], w[0])
@@ -149,6 +163,20 @@ var _ = infrastructure.DatastoreDescribe("_BPF-SAFE_ VXLAN topology before addin
 					cc.CheckConnectivity()
 				})
 
+				// PIt: bpf-gpl/skb.h's skb_mark_equals and bpf-gpl/ct_lookup_forward.c's
+				// calico_tc_is_forwarding now implement the forwarding classification
+				// calico_tc_process_ct_lookup would use to skip host-origin NAT-outgoing on
+				// forwarded traffic. What's missing is the surrounding conntrack-lookup
+				// function itself and the tc program build/load pipeline that would wire
+				// calico_tc_is_forwarding's result into an actual NAT decision -- neither is
+				// part of this snapshot, so there's no BPF-mode felix binary this FV could
+				// load its check into. Once that integration exists, this should send
+				// workload<->workload VXLAN traffic through a BPF-mode felix and assert the
+				// SNAT/NAT-outgoing counters don't increment for forwarded traffic, alongside
+				// the host-to-workload check above.
+				PIt("should not apply host-origin NAT-outgoing to forwarded workload to workload VXLAN traffic in BPF mode", func() {
+				})
+
 				It("should have host to host connectivity", func() {
 					cc.ExpectSome(felixes[0], hostW[1])
 					cc.ExpectSome(felixes[1], hostW[0])
@@ -231,6 +259,20 @@ var _ = infrastructure.DatastoreDescribe("_BPF-SAFE_ VXLAN topology before addin
 						cc.CheckConnectivity()
 					})
 
+					// PIt: bpf-gpl/ct_lookup_forward.c's calico_tc_skip_fib_for_unknown_hep_dest
+					// now implements the CALI_F_FROM_HEP fallthrough: CALI_ST_SKIP_FIB gets set
+					// when conntrack misses, there's no BPF NAT destination, and the route is
+					// non-local, so the epilogue would hand the packet to the host stack rather
+					// than FIB-redirect it. The surrounding calico_tc_process_ct_lookup function
+					// and the tc build/load pipeline that would call this and actually skip the
+					// FIB redirect aren't part of this snapshot, so there's no BPF-mode felix
+					// binary this FV could exercise. Once that integration exists, this should
+					// send a packet to a destination unknown to Calico (e.g. a broadcast) at a
+					// BPF-mode felix and assert it's policed by the host protection policy above
+					// but not dropped by BPF FIB redirect.
+					PIt("should police but not FIB-drop traffic to an unknown destination in BPF mode", func() {
+					})
+
 					It("should allow felixes[0] to reach felixes[1] if ingress and egress policies are in place", func() {
 						// Create a policy selecting felix[0] that allows egress.
 						policy := api.NewGlobalNetworkPolicy()
@@ -331,6 +373,20 @@ var _ = infrastructure.DatastoreDescribe("_BPF-SAFE_ VXLAN topology before addin
 							cc.ExpectSome(felixes[0], connectivity.TargetIP(serviceIP), 8055)
 							cc.CheckConnectivity()
 						})
+
+						// PIt: lib/backend/k8s/conversion.RulesForPeerAndPorts now collapses a
+						// NetworkPolicy rule's ports by protocol into a single api.Rule per
+						// protocol instead of one per (port, peer) combination. What's still
+						// missing is a way to drive it from this FV: every case in this file
+						// creates policy directly through client.GlobalNetworkPolicies(), and
+						// there's no helper here for creating a raw Kubernetes NetworkPolicy
+						// and letting Felix's k8s backend convert it, so there's no harness
+						// plumbing yet to install a multi-port KNP and read back the rendered
+						// rule count. Once that harness helper exists, this should install a
+						// KNP with many ports and assert iptables-save renders only one rule
+						// per protocol.
+						PIt("renders one iptables rule per protocol for a multi-port KNP", func() {
+						})
 					})
 				})
 
@@ -407,6 +463,16 @@ var _ = infrastructure.DatastoreDescribe("_BPF-SAFE_ VXLAN topology before addin
 							cc.ExpectNone(w[2], w[0])
 							cc.CheckConnectivity()
 						})
+
+						// PIt: the cali_vxlan_src BPF map and its tc-ingress source check
+						// (bpf-gpl/vxlan_src_whitelist.c) are implemented, mirroring
+						// cali40all-vxlan-net entry-for-entry. This stays pending because this
+						// snapshot has no BPF build/load pipeline or bpftool-based FV harness to
+						// run a BPF-mode felix against and assert `bpftool map dump` output --
+						// once that exists, this should repeat the manual-whitelist-edit case
+						// above, deleting the entry from cali_vxlan_src instead of from the ipset.
+						PIt("after manually removing third node from the BPF VXLAN source whitelist should have expected connectivity", func() {
+						})
 					}
 				})
 
@@ -481,6 +547,59 @@ var _ = infrastructure.DatastoreDescribe("_BPF-SAFE_ VXLAN topology before addin
 
 				})
 
+				Context("with IPv6 VXLAN also enabled", func() {
+					BeforeEach(func() {
+						topologyOptions := infrastructure.DefaultTopologyOptions()
+						topologyOptions.VXLANMode = vxlanMode
+						topologyOptions.IPIPEnabled = false
+						topologyOptions.EnableIPv6 = true
+						topologyOptions.ExtraEnvVars["FELIX_ROUTESOURCE"] = routeSource
+					})
+
+					// PIt: dataplane/linux/vxlan_v6.go now brings up the independent
+					// vxlan-v6.calico device with its own VNI/port/MTU
+					// (config.Config.VXLANMTUV6/VXLANVNIV6/VXLANPortV6), leaving the v4
+					// vxlan.calico device's own settings untouched. What's still missing is
+					// FelixConfigurationSpec.VXLANMTUV6/VXLANVNIV6/VXLANPortV6 themselves --
+					// those live in libcalico-go, an external dependency not vendored into
+					// this repo, so this FV has no way to set them through the datastore
+					// client yet. Once they land upstream, this should assert both devices
+					// come up with their own independent settings.
+					PIt("should configure an independent vxlan-v6.calico device", func() {
+					})
+
+					// PIt: exercising actual v6 workload-to-workload connectivity over
+					// vxlan-v6.calico needs a v6 IPAM pool and v6-addressed workloads, which
+					// this chunk's topology helpers don't set up. The device/config case above is
+					// pending as well, so this file asserts neither part of the dual-tunnel surface.
+					PIt("should have v6 workload to workload connectivity over vxlan-v6.calico", func() {
+					})
+				})
+
+				It("should ignore operationally-down interfaces when auto-detecting host MTU", func() {
+					for _, felix := range felixes {
+						felix.Exec("ip", "link", "add", "veth0", "type", "veth", "peer", "name", "veth0-peer")
+						felix.Exec("ip", "link", "set", "veth0", "mtu", "576")
+						felix.Exec("ip", "link", "set", "veth0", "up")
+						// veth0-peer is deliberately left down, so veth0's carrier -- and hence
+						// its operstate -- stays "down" even though veth0 itself is admin-up.
+					}
+
+					// MTU should remain based on eth0 (host MTU minus VXLAN overhead), ignoring
+					// the operationally-down veth0's smaller MTU.
+					for _, felix := range felixes {
+						Eventually(func() string {
+							out, _ := felix.ExecOutput("ip", "-d", "link", "show", "vxlan.calico")
+							return out
+						}, "60s", "100ms").Should(ContainSubstring("mtu 1450"))
+
+						Eventually(func() string {
+							out, _ := felix.ExecOutput("cat", "/var/lib/calico/mtu")
+							return out
+						}, "30s", "100ms").Should(ContainSubstring("1450"))
+					}
+				})
+
 				It("should delete the vxlan device when vxlan is disabled", func() {
 					// Wait for the VXLAN device to be created.
 					for _, felix := range felixes {
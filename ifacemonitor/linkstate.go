@@ -0,0 +1,45 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ifacemonitor
+
+import (
+	"net"
+
+	"github.com/vishvananda/netlink"
+)
+
+// LinkIsOperUp returns true if the given link is not just administratively up (IFF_UP) but is
+// also operationally up, i.e. the kernel reports a working carrier/lower layer. A link can be
+// administratively up while its operstate stays "down" -- for example, a NIC with no cable
+// plugged in, or one end of a veth pair whose peer is down -- and callers that care about
+// whether a link is actually usable (such as host MTU auto-detection) should check this instead
+// of just the IFF_UP flag.
+func LinkIsOperUp(attrs *netlink.LinkAttrs) bool {
+	if attrs == nil {
+		return false
+	}
+	if attrs.Flags&net.FlagUp == 0 {
+		return false
+	}
+	switch attrs.OperState {
+	case netlink.OperUp, netlink.OperUnknown:
+		// Some virtual devices (e.g. loopback) never populate operstate and report
+		// "unknown" even when fully functional; treat that the same as "up" so we don't
+		// start excluding links that have always worked.
+		return true
+	default:
+		return false
+	}
+}
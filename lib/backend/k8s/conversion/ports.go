@@ -0,0 +1,124 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package conversion translates Kubernetes NetworkPolicy resources into Calico policy rules.
+// Only the port-collapsing piece of the converter is reproduced here; the rest of the k8s
+// backend isn't part of this chunk.
+package conversion
+
+import (
+	"sort"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	api "github.com/projectcalico/libcalico-go/lib/apis/v3"
+	"github.com/projectcalico/libcalico-go/lib/numorstring"
+)
+
+// collapsePortsByProtocol groups a NetworkPolicy rule's ports by protocol, returning one
+// numorstring.Port slice per protocol. Previously the converter emitted one rule per
+// (port, peer) combination; grouping by protocol here lets the caller emit a single rule per
+// (protocol, peer) tuple instead, with Destination.Ports holding the union of ports for that
+// protocol.
+//
+// An empty/nil ports list -- "any port, any protocol" in the Kubernetes NetworkPolicy API --
+// collapses to a single entry keyed by the empty protocol with a nil Ports slice, preserving
+// today's "port-less, protocol-less" rule semantics rather than being treated as zero ports.
+// Likewise an entry with no Port (every port of its protocol) sets that protocol's slice to nil,
+// so the protocol's rule carries no port restriction.
+func collapsePortsByProtocol(ports []networkingv1.NetworkPolicyPort) map[string][]numorstring.Port {
+	grouped := map[string][]numorstring.Port{}
+	if len(ports) == 0 {
+		grouped[""] = nil
+		return grouped
+	}
+
+	anyPort := map[string]bool{}
+	for _, p := range ports {
+		proto := ""
+		if p.Protocol != nil {
+			proto = string(*p.Protocol)
+		}
+		if p.Port == nil {
+			anyPort[proto] = true
+			grouped[proto] = nil
+			continue
+		}
+		if !anyPort[proto] {
+			grouped[proto] = append(grouped[proto], k8sPortToPort(p))
+		}
+	}
+	return grouped
+}
+
+// k8sPortToPort converts a single NetworkPolicyPort (optionally a named port or a port range via
+// EndPort) into the equivalent numorstring.Port. A nil Port (every port) has no single-Port
+// equivalent and yields the zero Port; collapsePortsByProtocol handles that case itself.
+func k8sPortToPort(p networkingv1.NetworkPolicyPort) numorstring.Port {
+	if p.Port == nil {
+		return numorstring.Port{}
+	}
+	if p.Port.Type == intstr.String {
+		return numorstring.Port{PortName: p.Port.StrVal}
+	}
+	minPort := uint16(p.Port.IntValue())
+	maxPort := minPort
+	if p.EndPort != nil {
+		maxPort = uint16(*p.EndPort)
+	}
+	if minPort == maxPort {
+		return numorstring.SinglePort(minPort)
+	}
+	port, _ := numorstring.PortFromRange(minPort, maxPort)
+	return port
+}
+
+// sortedProtocols returns the keys of a collapsePortsByProtocol result in a stable order so
+// callers render rules deterministically, keeping the generated iptables chain stable across
+// re-renders when nothing has actually changed.
+func sortedProtocols(grouped map[string][]numorstring.Port) []string {
+	protos := make([]string, 0, len(grouped))
+	for p := range grouped {
+		protos = append(protos, p)
+	}
+	sort.Strings(protos)
+	return protos
+}
+
+// RulesForPeerAndPorts builds one api.Rule per protocol present in ports (or a single
+// protocol-less, port-less rule when ports is empty), reusing the same action and peer
+// (Destination selector/namespace/etc., minus Ports) across the group. This replaces the
+// previous one-rule-per-(port,peer) behaviour: callers now call this once per peer with the
+// rule's full port list, and get back one rule per protocol instead of one per port.
+func RulesForPeerAndPorts(action api.Action, peer api.EntityRule, ports []networkingv1.NetworkPolicyPort) []api.Rule {
+	grouped := collapsePortsByProtocol(ports)
+
+	rules := make([]api.Rule, 0, len(grouped))
+	for _, protoStr := range sortedProtocols(grouped) {
+		destPorts := grouped[protoStr]
+
+		rule := api.Rule{
+			Action:      action,
+			Destination: peer,
+		}
+		rule.Destination.Ports = destPorts
+		if protoStr != "" {
+			proto := numorstring.ProtocolFromString(protoStr)
+			rule.Protocol = &proto
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}